@@ -0,0 +1,59 @@
+package tfe
+
+import (
+	"context"
+	"log"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// ctx is passed to every go-tfe client call. The provider doesn't yet plumb
+// cancellation through from Terraform, so a background context is enough.
+var ctx = context.Background()
+
+// Provider returns a terraform.ResourceProvider.
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			"hostname": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("TFE_HOSTNAME", "app.terraform.io"),
+				Description: "The Terraform Enterprise hostname to connect to.",
+			},
+
+			"token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("TFE_TOKEN", nil),
+				Description: "The token used to authenticate with Terraform Enterprise.",
+			},
+		},
+
+		ResourcesMap: map[string]*schema.Resource{
+			"tfe_notification_configuration": resourceTFENotificationConfiguration(),
+			"tfe_variable":                   resourceTFEVariable(),
+			"tfe_workspace":                  resourceTFEWorkspace(),
+			"tfe_workspace_run_task":         resourceTFEWorkspaceRunTask(),
+		},
+
+		ConfigureFunc: providerConfigure,
+	}
+}
+
+func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+	config := &tfe.Config{
+		Address: "https://" + d.Get("hostname").(string),
+		Token:   d.Get("token").(string),
+	}
+
+	client, err := tfe.NewClient(config)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[INFO] Configured client for host: %s", d.Get("hostname").(string))
+
+	return client, nil
+}
@@ -0,0 +1,35 @@
+package tfe
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+var testAccProviders map[string]terraform.ResourceProvider
+var testAccProvider *schema.Provider
+
+func init() {
+	testAccProvider = Provider()
+	testAccProviders = map[string]terraform.ResourceProvider{
+		"tfe": testAccProvider,
+	}
+}
+
+func TestProvider(t *testing.T) {
+	if err := Provider().InternalValidate(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func testAccPreCheck(t *testing.T) {
+	if os.Getenv("TFE_TOKEN") == "" {
+		t.Fatal("TFE_TOKEN must be set for acceptance tests")
+	}
+
+	if os.Getenv("TFE_ORGANIZATION") == "" {
+		t.Fatal("TFE_ORGANIZATION must be set for acceptance tests")
+	}
+}
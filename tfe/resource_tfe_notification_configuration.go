@@ -0,0 +1,223 @@
+package tfe
+
+import (
+	"fmt"
+	"log"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceTFENotificationConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTFENotificationConfigurationCreate,
+		Read:   resourceTFENotificationConfigurationRead,
+		Update: resourceTFENotificationConfigurationUpdate,
+		Delete: resourceTFENotificationConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"destination_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice(
+					[]string{
+						string(tfe.NotificationDestinationTypeGeneric),
+						string(tfe.NotificationDestinationTypeSlack),
+						string(tfe.NotificationDestinationTypeEmail),
+					},
+					false,
+				),
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"token": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+
+			"triggers": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice(
+						[]string{
+							string(tfe.NotificationTriggerCreated),
+							string(tfe.NotificationTriggerNeedsAttention),
+							string(tfe.NotificationTriggerCompleted),
+							string(tfe.NotificationTriggerErrored),
+						},
+						false,
+					),
+				},
+			},
+
+			"url": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"email_user_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"workspace_external_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceTFENotificationConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	wsID := d.Get("workspace_external_id").(string)
+
+	destinationType := tfe.NotificationDestinationType(d.Get("destination_type").(string))
+
+	options := tfe.NotificationConfigurationCreateOptions{
+		DestinationType: &destinationType,
+		Enabled:         tfe.Bool(d.Get("enabled").(bool)),
+		Name:            tfe.String(d.Get("name").(string)),
+	}
+
+	if token, ok := d.GetOk("token"); ok {
+		options.Token = tfe.String(token.(string))
+	}
+
+	if url, ok := d.GetOk("url"); ok {
+		options.URL = tfe.String(url.(string))
+	}
+
+	if triggers, ok := d.GetOk("triggers"); ok {
+		for _, trigger := range triggers.([]interface{}) {
+			options.Triggers = append(options.Triggers, tfe.NotificationTriggerType(trigger.(string)))
+		}
+	}
+
+	if userIDs, ok := d.GetOk("email_user_ids"); ok {
+		for _, userID := range userIDs.([]interface{}) {
+			options.EmailUsers = append(options.EmailUsers, &tfe.User{ID: userID.(string)})
+		}
+	}
+
+	log.Printf("[DEBUG] Create notification configuration %s for workspace: %s", d.Get("name").(string), wsID)
+	nc, err := tfeClient.NotificationConfigurations.Create(ctx, wsID, options)
+	if err != nil {
+		return fmt.Errorf(
+			"Error creating notification configuration %s for workspace %s: %v", d.Get("name").(string), wsID, err)
+	}
+
+	d.SetId(nc.ID)
+
+	return resourceTFENotificationConfigurationRead(d, meta)
+}
+
+func resourceTFENotificationConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	log.Printf("[DEBUG] Read configuration of notification configuration: %s", d.Id())
+	nc, err := tfeClient.NotificationConfigurations.Read(ctx, d.Id())
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			log.Printf("[DEBUG] Notification configuration %s does no longer exist", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading configuration of notification configuration %s: %v", d.Id(), err)
+	}
+
+	d.Set("name", nc.Name)
+	d.Set("destination_type", string(nc.DestinationType))
+	d.Set("enabled", nc.Enabled)
+	d.Set("url", nc.URL)
+
+	var triggers []interface{}
+	for _, trigger := range nc.Triggers {
+		triggers = append(triggers, string(trigger))
+	}
+	d.Set("triggers", triggers)
+
+	var userIDs []interface{}
+	for _, user := range nc.EmailUsers {
+		userIDs = append(userIDs, user.ID)
+	}
+	d.Set("email_user_ids", userIDs)
+
+	if nc.Subscribable != nil {
+		d.Set("workspace_external_id", nc.Subscribable.ID)
+	}
+
+	return nil
+}
+
+func resourceTFENotificationConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	if d.HasChange("name") || d.HasChange("enabled") || d.HasChange("token") ||
+		d.HasChange("triggers") || d.HasChange("url") || d.HasChange("email_user_ids") {
+		options := tfe.NotificationConfigurationUpdateOptions{
+			Enabled: tfe.Bool(d.Get("enabled").(bool)),
+			Name:    tfe.String(d.Get("name").(string)),
+		}
+
+		// Use Get (not GetOk) for these so that clearing a previously set
+		// value in config actually propagates the empty value to TFE,
+		// instead of leaving the old value in place.
+		options.Token = tfe.String(d.Get("token").(string))
+		options.URL = tfe.String(d.Get("url").(string))
+
+		options.Triggers = []tfe.NotificationTriggerType{}
+		for _, trigger := range d.Get("triggers").([]interface{}) {
+			options.Triggers = append(options.Triggers, tfe.NotificationTriggerType(trigger.(string)))
+		}
+
+		options.EmailUsers = []*tfe.User{}
+		for _, userID := range d.Get("email_user_ids").([]interface{}) {
+			options.EmailUsers = append(options.EmailUsers, &tfe.User{ID: userID.(string)})
+		}
+
+		log.Printf("[DEBUG] Update notification configuration: %s", d.Id())
+		_, err := tfeClient.NotificationConfigurations.Update(ctx, d.Id(), options)
+		if err != nil {
+			return fmt.Errorf("Error updating notification configuration %s: %v", d.Id(), err)
+		}
+	}
+
+	return resourceTFENotificationConfigurationRead(d, meta)
+}
+
+func resourceTFENotificationConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	log.Printf("[DEBUG] Delete notification configuration: %s", d.Id())
+	err := tfeClient.NotificationConfigurations.Delete(ctx, d.Id())
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			return nil
+		}
+		return fmt.Errorf("Error deleting notification configuration %s: %v", d.Id(), err)
+	}
+
+	return nil
+}
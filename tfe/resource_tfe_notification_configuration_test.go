@@ -0,0 +1,151 @@
+package tfe
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccTFENotificationConfiguration_basic(t *testing.T) {
+	nc := &tfe.NotificationConfiguration{}
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFENotificationConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFENotificationConfiguration_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFENotificationConfigurationExists(
+						"tfe_notification_configuration.foobar", nc),
+					resource.TestCheckResourceAttr(
+						"tfe_notification_configuration.foobar", "name", fmt.Sprintf("nc-test-%d", rInt)),
+					resource.TestCheckResourceAttr(
+						"tfe_notification_configuration.foobar", "destination_type", "generic"),
+					resource.TestCheckResourceAttr(
+						"tfe_notification_configuration.foobar", "triggers.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTFENotificationConfiguration_update(t *testing.T) {
+	nc := &tfe.NotificationConfiguration{}
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFENotificationConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFENotificationConfiguration_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFENotificationConfigurationExists(
+						"tfe_notification_configuration.foobar", nc),
+					resource.TestCheckResourceAttr(
+						"tfe_notification_configuration.foobar", "triggers.#", "2"),
+				),
+			},
+			{
+				// Clearing every trigger and the url must actually clear them
+				// in TFE, not just stop managing them.
+				Config: testAccTFENotificationConfiguration_cleared(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFENotificationConfigurationExists(
+						"tfe_notification_configuration.foobar", nc),
+					resource.TestCheckResourceAttr(
+						"tfe_notification_configuration.foobar", "triggers.#", "0"),
+					resource.TestCheckResourceAttr(
+						"tfe_notification_configuration.foobar", "url", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTFENotificationConfigurationExists(
+	n string, nc *tfe.NotificationConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		tfeClient := testAccProvider.Meta().(*tfe.Client)
+
+		found, err := tfeClient.NotificationConfigurations.Read(ctx, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Notification configuration not found")
+		}
+
+		*nc = *found
+
+		return nil
+	}
+}
+
+func testAccCheckTFENotificationConfigurationDestroy(s *terraform.State) error {
+	tfeClient := testAccProvider.Meta().(*tfe.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tfe_notification_configuration" {
+			continue
+		}
+
+		_, err := tfeClient.NotificationConfigurations.Read(ctx, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Notification configuration %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccTFENotificationConfiguration_basic(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test-%d"
+  organization = "%s"
+}
+
+resource "tfe_notification_configuration" "foobar" {
+  name                  = "nc-test-%d"
+  destination_type      = "generic"
+  enabled               = true
+  triggers              = ["run:created", "run:errored"]
+  url                   = "https://example.com/hook"
+  workspace_external_id = tfe_workspace.foobar.external_id
+}`, rInt, os.Getenv("TFE_ORGANIZATION"), rInt)
+}
+
+func testAccTFENotificationConfiguration_cleared(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test-%d"
+  organization = "%s"
+}
+
+resource "tfe_notification_configuration" "foobar" {
+  name                  = "nc-test-%d"
+  destination_type      = "generic"
+  enabled               = true
+  workspace_external_id = tfe_workspace.foobar.external_id
+}`, rInt, os.Getenv("TFE_ORGANIZATION"), rInt)
+}
@@ -0,0 +1,178 @@
+package tfe
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceTFEVariable() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTFEVariableCreate,
+		Read:   resourceTFEVariableRead,
+		Update: resourceTFEVariableUpdate,
+		Delete: resourceTFEVariableDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceTFEVariableImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"value": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+
+			"category": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice(
+					[]string{
+						string(tfe.CategoryTerraform),
+						string(tfe.CategoryEnv),
+					},
+					false,
+				),
+			},
+
+			"hcl": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"sensitive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"workspace_external_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceTFEVariableCreate(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	key := d.Get("key").(string)
+	wsID := d.Get("workspace_external_id").(string)
+
+	options := tfe.VariableCreateOptions{
+		Key:       tfe.String(key),
+		Value:     tfe.String(d.Get("value").(string)),
+		Category:  tfe.Category(tfe.CategoryType(d.Get("category").(string))),
+		HCL:       tfe.Bool(d.Get("hcl").(bool)),
+		Sensitive: tfe.Bool(d.Get("sensitive").(bool)),
+	}
+
+	log.Printf("[DEBUG] Create %s variable: %s for workspace: %s", d.Get("category").(string), key, wsID)
+	variable, err := tfeClient.Variables.Create(ctx, wsID, options)
+	if err != nil {
+		return fmt.Errorf("Error creating %s variable %s for workspace %s: %v", d.Get("category").(string), key, wsID, err)
+	}
+
+	d.SetId(variable.ID)
+
+	return resourceTFEVariableRead(d, meta)
+}
+
+func resourceTFEVariableRead(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	wsID := d.Get("workspace_external_id").(string)
+
+	log.Printf("[DEBUG] Read variable: %s", d.Id())
+	variable, err := tfeClient.Variables.Read(ctx, wsID, d.Id())
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			log.Printf("[DEBUG] Variable %s does no longer exist", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading variable %s: %v", d.Id(), err)
+	}
+
+	d.Set("key", variable.Key)
+	d.Set("category", string(variable.Category))
+	d.Set("hcl", variable.HCL)
+	d.Set("sensitive", variable.Sensitive)
+
+	// Don't overwrite the value if it's sensitive, since the API does not
+	// return it back to us.
+	if !variable.Sensitive {
+		d.Set("value", variable.Value)
+	}
+
+	return nil
+}
+
+func resourceTFEVariableUpdate(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	wsID := d.Get("workspace_external_id").(string)
+
+	if d.HasChange("key") || d.HasChange("value") || d.HasChange("hcl") || d.HasChange("sensitive") {
+		options := tfe.VariableUpdateOptions{
+			Key:       tfe.String(d.Get("key").(string)),
+			Value:     tfe.String(d.Get("value").(string)),
+			HCL:       tfe.Bool(d.Get("hcl").(bool)),
+			Sensitive: tfe.Bool(d.Get("sensitive").(bool)),
+		}
+
+		log.Printf("[DEBUG] Update variable: %s", d.Id())
+		_, err := tfeClient.Variables.Update(ctx, wsID, d.Id(), options)
+		if err != nil {
+			return fmt.Errorf("Error updating variable %s: %v", d.Id(), err)
+		}
+	}
+
+	return resourceTFEVariableRead(d, meta)
+}
+
+// resourceTFEVariableImport parses the <WORKSPACE EXTERNAL ID>/<VARIABLE ID>
+// import ID, since a variable cannot be read without knowing the workspace
+// it belongs to.
+func resourceTFEVariableImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	s := strings.SplitN(d.Id(), "/", 2)
+	if len(s) != 2 {
+		return nil, fmt.Errorf(
+			"invalid variable import format: %s (expected <WORKSPACE EXTERNAL ID>/<VARIABLE ID>)", d.Id())
+	}
+
+	d.Set("workspace_external_id", s[0])
+	d.SetId(s[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceTFEVariableDelete(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	wsID := d.Get("workspace_external_id").(string)
+
+	log.Printf("[DEBUG] Delete variable: %s", d.Id())
+	err := tfeClient.Variables.Delete(ctx, wsID, d.Id())
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			return nil
+		}
+		return fmt.Errorf("Error deleting variable %s: %v", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package tfe
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccTFEVariable_basic(t *testing.T) {
+	variable := &tfe.Variable{}
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEVariableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEVariable_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEVariableExists(
+						"tfe_variable.foobar", variable),
+					resource.TestCheckResourceAttr(
+						"tfe_variable.foobar", "key", "key_test"),
+					resource.TestCheckResourceAttr(
+						"tfe_variable.foobar", "value", "value_test"),
+					resource.TestCheckResourceAttr(
+						"tfe_variable.foobar", "category", "terraform"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTFEVariable_import(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEVariableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEVariable_basic(rInt),
+			},
+			{
+				ResourceName:      "tfe_variable.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccTFEVariableImportStateIdFunc,
+			},
+		},
+	})
+}
+
+func testAccTFEVariableImportStateIdFunc(s *terraform.State) (string, error) {
+	rs, ok := s.RootModule().Resources["tfe_variable.foobar"]
+	if !ok {
+		return "", fmt.Errorf("Not found: tfe_variable.foobar")
+	}
+
+	return fmt.Sprintf("%s/%s", rs.Primary.Attributes["workspace_external_id"], rs.Primary.ID), nil
+}
+
+func testAccCheckTFEVariableExists(n string, variable *tfe.Variable) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		tfeClient := testAccProvider.Meta().(*tfe.Client)
+
+		found, err := tfeClient.Variables.Read(ctx, rs.Primary.Attributes["workspace_external_id"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Variable not found")
+		}
+
+		*variable = *found
+
+		return nil
+	}
+}
+
+func testAccCheckTFEVariableDestroy(s *terraform.State) error {
+	tfeClient := testAccProvider.Meta().(*tfe.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tfe_variable" {
+			continue
+		}
+
+		_, err := tfeClient.Variables.Read(ctx, rs.Primary.Attributes["workspace_external_id"], rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Variable %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccTFEVariable_basic(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test-%d"
+  organization = "%s"
+}
+
+resource "tfe_variable" "foobar" {
+  key                   = "key_test"
+  value                 = "value_test"
+  category              = "terraform"
+  workspace_external_id = tfe_workspace.foobar.external_id
+}`, rInt, os.Getenv("TFE_ORGANIZATION"))
+}
@@ -1,10 +1,18 @@
 package tfe
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"log"
+	"os"
+	"path/filepath"
 	"strings"
+	"time"
 
+	slug "github.com/hashicorp/go-slug"
 	tfe "github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
@@ -19,6 +27,10 @@ func resourceTFEWorkspace() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		SchemaVersion: 1,
+		MigrateState:  resourceTFEWorkspaceMigrateState,
+		CustomizeDiff: resourceTFEWorkspaceCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -37,6 +49,12 @@ func resourceTFEWorkspace() *schema.Resource {
 				Default:  false,
 			},
 
+			"cost_estimation_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"file_triggers_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -49,12 +67,24 @@ func resourceTFEWorkspace() *schema.Resource {
 				Default:  true,
 			},
 
+			"policy_set_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
 			"queue_all_runs": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  true,
 			},
 
+			"run_triggers": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
 			"ssh_key_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -80,10 +110,11 @@ func resourceTFEWorkspace() *schema.Resource {
 			},
 
 			"vcs_repo": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MinItems: 1,
-				MaxItems: 1,
+				Type:          schema.TypeList,
+				Optional:      true,
+				MinItems:      1,
+				MaxItems:      1,
+				ConflictsWith: []string{"configuration"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"identifier": {
@@ -110,6 +141,38 @@ func resourceTFEWorkspace() *schema.Resource {
 				},
 			},
 
+			"configuration": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				MinItems:      1,
+				MaxItems:      1,
+				ConflictsWith: []string{"vcs_repo"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"directory": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"auto_queue_runs": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						// directory_sha256 is recomputed on every plan by
+						// resourceTFEWorkspaceCustomizeDiff. Since "directory" is
+						// just a path, editing the files inside it wouldn't
+						// otherwise show up as a change to this block.
+						"directory_sha256": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"external_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -127,11 +190,12 @@ func resourceTFEWorkspaceCreate(d *schema.ResourceData, meta interface{}) error
 
 	// Create a new options struct.
 	options := tfe.WorkspaceCreateOptions{
-		Name:                tfe.String(name),
-		AutoApply:           tfe.Bool(d.Get("auto_apply").(bool)),
-		FileTriggersEnabled: tfe.Bool(d.Get("file_triggers_enabled").(bool)),
-		Operations:          tfe.Bool(d.Get("operations").(bool)),
-		QueueAllRuns:        tfe.Bool(d.Get("queue_all_runs").(bool)),
+		Name:                  tfe.String(name),
+		AutoApply:             tfe.Bool(d.Get("auto_apply").(bool)),
+		CostEstimationEnabled: tfe.Bool(d.Get("cost_estimation_enabled").(bool)),
+		FileTriggersEnabled:   tfe.Bool(d.Get("file_triggers_enabled").(bool)),
+		Operations:            tfe.Bool(d.Get("operations").(bool)),
+		QueueAllRuns:          tfe.Bool(d.Get("queue_all_runs").(bool)),
 	}
 
 	// Process all configured options.
@@ -175,12 +239,7 @@ func resourceTFEWorkspaceCreate(d *schema.ResourceData, meta interface{}) error
 			"Error creating workspace %s for organization %s: %v", name, organization, err)
 	}
 
-	id, err := packWorkspaceID(workspace)
-	if err != nil {
-		return fmt.Errorf("Error creating ID for workspace %s: %v", name, err)
-	}
-
-	d.SetId(id)
+	d.SetId(workspace.ID)
 
 	if sshKeyID, ok := d.GetOk("ssh_key_id"); ok {
 		_, err = tfeClient.Workspaces.AssignSSHKey(ctx, workspace.ID, tfe.WorkspaceAssignSSHKeyOptions{
@@ -191,67 +250,65 @@ func resourceTFEWorkspaceCreate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	return resourceTFEWorkspaceRead(d, meta)
-}
-
-func resourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+	if v, ok := d.GetOk("configuration"); ok {
+		configuration := v.([]interface{})[0].(map[string]interface{})
 
-	// Get the organization and workspace name.
-	organization, name, err := unpackWorkspaceID(d.Id())
-	if err != nil {
-		return fmt.Errorf("Error unpacking workspace ID: %v", err)
-	}
-
-	log.Printf("[DEBUG] Read configuration of workspace: %s", name)
-	workspace, err := tfeClient.Workspaces.Read(ctx, organization, name)
-	if err != nil && err != tfe.ErrResourceNotFound {
-		return fmt.Errorf("Error reading configuration of workspace %s: %v", name, err)
+		err := resourceTFEWorkspaceUploadConfiguration(
+			tfeClient,
+			workspace.ID,
+			configuration["directory"].(string),
+			configuration["auto_queue_runs"].(bool),
+		)
+		if err != nil {
+			return fmt.Errorf("Error publishing configuration version for workspace %s: %v", name, err)
+		}
 	}
 
-	// If we cannot find the workspace, it either doesn't exist anymore or is
-	// renamed. To make sure the workspace is really gone before we delete it
-	// from our state, we will list all workspaces and try to find it using
-	// the external ID.
-	if err == tfe.ErrResourceNotFound {
-		// Set the workspace to nil so we can check if we found one later.
-		workspace = nil
-
-		options := tfe.WorkspaceListOptions{}
-		externalID := d.Get("external_id").(string)
-		for {
-			wl, err := tfeClient.Workspaces.List(ctx, organization, options)
+	if rts, ok := d.GetOk("run_triggers"); ok {
+		for _, rt := range rts.(*schema.Set).List() {
+			_, err := tfeClient.RunTriggers.Create(ctx, workspace.ID, tfe.RunTriggerCreateOptions{
+				Sourceable: &tfe.Workspace{ID: rt.(string)},
+			})
 			if err != nil {
-				return fmt.Errorf("Error retrieving workspaces: %v", err)
+				return fmt.Errorf(
+					"Error creating run trigger from workspace %s on workspace %s: %v", rt.(string), name, err)
 			}
+		}
+	}
 
-			for _, w := range wl.Items {
-				if externalID == w.ID {
-					workspace = w
-					break
-				}
+	if psIDs, ok := d.GetOk("policy_set_ids"); ok {
+		for _, psID := range psIDs.(*schema.Set).List() {
+			_, err := tfeClient.PolicySets.AddWorkspaces(ctx, psID.(string), tfe.PolicySetAddWorkspacesOptions{
+				Workspaces: []*tfe.Workspace{{ID: workspace.ID}},
+			})
+			if err != nil {
+				return fmt.Errorf(
+					"Error attaching policy set %s to workspace %s: %v", psID.(string), name, err)
 			}
+		}
+	}
 
-			// Exit the loop if we found the workspace or have seen all pages.
-			if workspace != nil || wl.CurrentPage >= wl.TotalPages {
-				break
-			}
+	return resourceTFEWorkspaceRead(d, meta)
+}
 
-			// Update the page number to get the next page.
-			options.PageNumber = wl.NextPage
-		}
+func resourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
 
-		// Return if we didn't find a matching workspace.
-		if workspace == nil {
-			log.Printf("[DEBUG] Workspace %s does no longer exist", name)
+	log.Printf("[DEBUG] Read configuration of workspace: %s", d.Id())
+	workspace, err := tfeClient.Workspaces.ReadByID(ctx, d.Id())
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			log.Printf("[DEBUG] Workspace %s does no longer exist", d.Id())
 			d.SetId("")
 			return nil
 		}
+		return fmt.Errorf("Error reading configuration of workspace %s: %v", d.Id(), err)
 	}
 
 	// Update the config.
 	d.Set("name", workspace.Name)
 	d.Set("auto_apply", workspace.AutoApply)
+	d.Set("cost_estimation_enabled", workspace.CostEstimationEnabled)
 	d.Set("file_triggers_enabled", workspace.FileTriggersEnabled)
 	d.Set("operations", workspace.Operations)
 	d.Set("queue_all_runs", workspace.QueueAllRuns)
@@ -293,13 +350,53 @@ func resourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("vcs_repo", vcsRepo)
 
-	// We do this here as a means to convert the internal ID,
-	// in case anyone still uses the old format.
-	id, err := packWorkspaceID(workspace)
-	if err != nil {
-		return err
+	var runTriggers []interface{}
+	options := tfe.RunTriggerListOptions{RunTriggerType: tfe.String("inbound")}
+	for {
+		rtl, err := tfeClient.RunTriggers.List(ctx, workspace.ID, options)
+		if err != nil {
+			return fmt.Errorf("Error retrieving run triggers for workspace %s: %v", workspace.Name, err)
+		}
+
+		for _, rt := range rtl.Items {
+			if rt.Sourceable != nil {
+				runTriggers = append(runTriggers, rt.Sourceable.ID)
+			}
+		}
+
+		if rtl.CurrentPage >= rtl.TotalPages {
+			break
+		}
+		options.PageNumber = rtl.NextPage
+	}
+	d.Set("run_triggers", runTriggers)
+
+	if workspace.Organization != nil {
+		var policySetIDs []interface{}
+		// Include the workspaces relationship explicitly; PolicySets.List does
+		// not populate PolicySet.Workspaces on the plain listing endpoint.
+		psOptions := tfe.PolicySetListOptions{Include: []string{"workspaces"}}
+		for {
+			psl, err := tfeClient.PolicySets.List(ctx, workspace.Organization.Name, psOptions)
+			if err != nil {
+				return fmt.Errorf("Error retrieving policy sets for organization %s: %v", workspace.Organization.Name, err)
+			}
+
+			for _, ps := range psl.Items {
+				for _, w := range ps.Workspaces {
+					if w.ID == workspace.ID {
+						policySetIDs = append(policySetIDs, ps.ID)
+					}
+				}
+			}
+
+			if psl.CurrentPage >= psl.TotalPages {
+				break
+			}
+			psOptions.PageNumber = psl.NextPage
+		}
+		d.Set("policy_set_ids", policySetIDs)
 	}
-	d.SetId(id)
 
 	return nil
 }
@@ -307,23 +404,20 @@ func resourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
 func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error {
 	tfeClient := meta.(*tfe.Client)
 
-	// Get the organization and workspace name.
-	organization, name, err := unpackWorkspaceID(d.Id())
-	if err != nil {
-		return fmt.Errorf("Error unpacking workspace ID: %v", err)
-	}
+	name := d.Get("name").(string)
 
 	if d.HasChange("name") || d.HasChange("auto_apply") || d.HasChange("queue_all_runs") ||
 		d.HasChange("terraform_version") || d.HasChange("working_directory") || d.HasChange("vcs_repo") ||
 		d.HasChange("file_triggers_enabled") || d.HasChange("trigger_prefixes") ||
-		d.HasChange("operations") {
+		d.HasChange("operations") || d.HasChange("cost_estimation_enabled") {
 		// Create a new options struct.
 		options := tfe.WorkspaceUpdateOptions{
-			Name:                tfe.String(d.Get("name").(string)),
-			AutoApply:           tfe.Bool(d.Get("auto_apply").(bool)),
-			FileTriggersEnabled: tfe.Bool(d.Get("file_triggers_enabled").(bool)),
-			Operations:          tfe.Bool(d.Get("operations").(bool)),
-			QueueAllRuns:        tfe.Bool(d.Get("queue_all_runs").(bool)),
+			Name:                  tfe.String(d.Get("name").(string)),
+			AutoApply:             tfe.Bool(d.Get("auto_apply").(bool)),
+			CostEstimationEnabled: tfe.Bool(d.Get("cost_estimation_enabled").(bool)),
+			FileTriggersEnabled:   tfe.Bool(d.Get("file_triggers_enabled").(bool)),
+			Operations:            tfe.Bool(d.Get("operations").(bool)),
+			QueueAllRuns:          tfe.Bool(d.Get("queue_all_runs").(bool)),
 		}
 
 		// Process all configured options.
@@ -356,29 +450,20 @@ func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error
 
 		log.Printf("[DEBUG] Update Options: %#v", options)
 
-		log.Printf("[DEBUG] Update workspace %s for organization: %s", name, organization)
-		workspace, err := tfeClient.Workspaces.Update(ctx, organization, name, options)
+		log.Printf("[DEBUG] Update workspace: %s", d.Id())
+		_, err := tfeClient.Workspaces.UpdateByID(ctx, d.Id(), options)
 		if err != nil {
-			return fmt.Errorf(
-				"Error updating workspace %s for organization %s: %v", name, organization, err)
+			return fmt.Errorf("Error updating workspace %s: %v", d.Id(), err)
 		}
-
-		id, err := packWorkspaceID(workspace)
-		if err != nil {
-			return fmt.Errorf("Error creating ID for workspace %s: %v", name, err)
-		}
-
-		d.SetId(id)
 	}
 
 	if d.HasChange("ssh_key_id") {
 		sshKeyID := d.Get("ssh_key_id").(string)
-		externalID, _ := d.GetChange("external_id")
 
 		if sshKeyID != "" {
 			_, err := tfeClient.Workspaces.AssignSSHKey(
 				ctx,
-				externalID.(string),
+				d.Id(),
 				tfe.WorkspaceAssignSSHKeyOptions{
 					SSHKeyID: tfe.String(sshKeyID),
 				},
@@ -387,45 +472,119 @@ func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error
 				return fmt.Errorf("Error assigning SSH key to workspace %s: %v", name, err)
 			}
 		} else {
-			_, err := tfeClient.Workspaces.UnassignSSHKey(ctx, externalID.(string))
+			_, err := tfeClient.Workspaces.UnassignSSHKey(ctx, d.Id())
 			if err != nil {
 				return fmt.Errorf("Error unassigning SSH key from workspace %s: %v", name, err)
 			}
 		}
 	}
 
+	if d.HasChange("configuration") {
+		if v, ok := d.GetOk("configuration"); ok {
+			configuration := v.([]interface{})[0].(map[string]interface{})
+
+			err := resourceTFEWorkspaceUploadConfiguration(
+				tfeClient,
+				d.Id(),
+				configuration["directory"].(string),
+				configuration["auto_queue_runs"].(bool),
+			)
+			if err != nil {
+				return fmt.Errorf("Error publishing configuration version for workspace %s: %v", name, err)
+			}
+		}
+	}
+
+	if d.HasChange("run_triggers") {
+		workspaceID := d.Id()
+
+		old, new := d.GetChange("run_triggers")
+		oldRTs := old.(*schema.Set)
+		newRTs := new.(*schema.Set)
+
+		rtOptions := tfe.RunTriggerListOptions{RunTriggerType: tfe.String("inbound")}
+		for {
+			rtl, err := tfeClient.RunTriggers.List(ctx, workspaceID, rtOptions)
+			if err != nil {
+				return fmt.Errorf("Error retrieving run triggers for workspace %s: %v", name, err)
+			}
+
+			for _, rt := range rtl.Items {
+				if rt.Sourceable == nil || newRTs.Contains(rt.Sourceable.ID) {
+					continue
+				}
+				if err := tfeClient.RunTriggers.Delete(ctx, rt.ID); err != nil {
+					return fmt.Errorf("Error removing run trigger from workspace %s: %v", name, err)
+				}
+			}
+
+			if rtl.CurrentPage >= rtl.TotalPages {
+				break
+			}
+			rtOptions.PageNumber = rtl.NextPage
+		}
+
+		for _, rt := range newRTs.Difference(oldRTs).List() {
+			_, err := tfeClient.RunTriggers.Create(ctx, workspaceID, tfe.RunTriggerCreateOptions{
+				Sourceable: &tfe.Workspace{ID: rt.(string)},
+			})
+			if err != nil {
+				return fmt.Errorf(
+					"Error creating run trigger from workspace %s on workspace %s: %v", rt.(string), name, err)
+			}
+		}
+	}
+
+	if d.HasChange("policy_set_ids") {
+		workspaceID := d.Id()
+
+		old, new := d.GetChange("policy_set_ids")
+		oldPSIDs := old.(*schema.Set)
+		newPSIDs := new.(*schema.Set)
+
+		for _, psID := range oldPSIDs.Difference(newPSIDs).List() {
+			_, err := tfeClient.PolicySets.RemoveWorkspaces(ctx, psID.(string), tfe.PolicySetRemoveWorkspacesOptions{
+				Workspaces: []*tfe.Workspace{{ID: workspaceID}},
+			})
+			if err != nil {
+				return fmt.Errorf(
+					"Error detaching policy set %s from workspace %s: %v", psID.(string), name, err)
+			}
+		}
+
+		for _, psID := range newPSIDs.Difference(oldPSIDs).List() {
+			_, err := tfeClient.PolicySets.AddWorkspaces(ctx, psID.(string), tfe.PolicySetAddWorkspacesOptions{
+				Workspaces: []*tfe.Workspace{{ID: workspaceID}},
+			})
+			if err != nil {
+				return fmt.Errorf(
+					"Error attaching policy set %s to workspace %s: %v", psID.(string), name, err)
+			}
+		}
+	}
+
 	return resourceTFEWorkspaceRead(d, meta)
 }
 
 func resourceTFEWorkspaceDelete(d *schema.ResourceData, meta interface{}) error {
 	tfeClient := meta.(*tfe.Client)
 
-	// Get the organization and workspace name.
-	organization, name, err := unpackWorkspaceID(d.Id())
-	if err != nil {
-		return fmt.Errorf("Error unpacking workspace ID: %v", err)
-	}
-
-	log.Printf("[DEBUG] Delete workspace %s from organization: %s", name, organization)
-	err = tfeClient.Workspaces.Delete(ctx, organization, name)
+	log.Printf("[DEBUG] Delete workspace: %s", d.Id())
+	err := tfeClient.Workspaces.DeleteByID(ctx, d.Id())
 	if err != nil {
 		if err == tfe.ErrResourceNotFound {
 			return nil
 		}
-		return fmt.Errorf(
-			"Error deleting workspace %s from organization %s: %v", name, organization, err)
+		return fmt.Errorf("Error deleting workspace %s: %v", d.Id(), err)
 	}
 
 	return nil
 }
 
-func packWorkspaceID(w *tfe.Workspace) (id string, err error) {
-	if w.Organization == nil {
-		return "", fmt.Errorf("no organization in workspace response")
-	}
-	return w.Organization.Name + "/" + w.Name, nil
-}
-
+// unpackWorkspaceID splits a legacy "<ORGANIZATION>/<WORKSPACE>" or
+// "<WORKSPACE>|<ORGANIZATION>" style ID, as produced by versions of this
+// resource predating the external-ID-based addressing. It is only used by
+// resourceTFEWorkspaceMigrateState to resolve the stable external ID.
 func unpackWorkspaceID(id string) (organization, name string, err error) {
 	// Support the old ID format for backwards compatibitily.
 	if s := strings.SplitN(id, "|", 2); len(s) == 2 {
@@ -440,3 +599,108 @@ func unpackWorkspaceID(id string) (organization, name string, err error) {
 
 	return s[0], s[1], nil
 }
+
+// resourceTFEWorkspaceCustomizeDiff recomputes the checksum of the local
+// "configuration" directory on every plan, so that editing the files inside
+// it (without changing the directory path itself) is detected as a change
+// and triggers a new configuration version upload on the next apply.
+func resourceTFEWorkspaceCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	v, ok := d.GetOk("configuration")
+	if !ok {
+		return nil
+	}
+	configuration := v.([]interface{})[0].(map[string]interface{})
+
+	digest, err := hashDirectory(configuration["directory"].(string))
+	if err != nil {
+		return fmt.Errorf("Error hashing configuration directory %s: %v", configuration["directory"].(string), err)
+	}
+
+	configuration["directory_sha256"] = digest
+
+	return d.SetNew("configuration", []interface{}{configuration})
+}
+
+// hashDirectory returns a hex-encoded SHA-256 digest covering the relative
+// path and contents of every file beneath directory.
+func hashDirectory(directory string) (string, error) {
+	h := sha256.New()
+
+	err := filepath.Walk(directory, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(directory, path)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(h, "%s\x00", rel)
+
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		if _, err := io.Copy(h, f); err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// resourceTFEWorkspaceUploadConfiguration packages the Terraform configuration
+// in directory as a slug and uploads it to the workspace as a new
+// configuration version, then waits for the upload to be processed.
+func resourceTFEWorkspaceUploadConfiguration(tfeClient *tfe.Client, workspaceID, directory string, autoQueueRuns bool) error {
+	slugFile, err := ioutil.TempFile("", "tfe-configuration-version")
+	if err != nil {
+		return fmt.Errorf("Error creating temporary file to hold slug: %v", err)
+	}
+	defer os.Remove(slugFile.Name())
+	defer slugFile.Close()
+
+	if _, err := slug.Pack(directory, slugFile, true); err != nil {
+		return fmt.Errorf("Error packaging configuration directory %s: %v", directory, err)
+	}
+
+	cv, err := tfeClient.ConfigurationVersions.Create(ctx, workspaceID, tfe.ConfigurationVersionCreateOptions{
+		AutoQueueRuns: tfe.Bool(autoQueueRuns),
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating configuration version: %v", err)
+	}
+
+	if err := tfeClient.ConfigurationVersions.Upload(ctx, cv.UploadURL, slugFile.Name()); err != nil {
+		return fmt.Errorf("Error uploading configuration version: %v", err)
+	}
+
+	for i := 0; i < 30; i++ {
+		cv, err = tfeClient.ConfigurationVersions.Read(ctx, cv.ID)
+		if err != nil {
+			return fmt.Errorf("Error reading configuration version %s: %v", cv.ID, err)
+		}
+
+		if cv.Status == tfe.ConfigurationUploaded {
+			return nil
+		}
+
+		if cv.Status == tfe.ConfigurationErrored {
+			return fmt.Errorf("Configuration version %s failed to process", cv.ID)
+		}
+
+		time.Sleep(2 * time.Second)
+	}
+
+	return fmt.Errorf("Timed out waiting for configuration version %s to be processed", cv.ID)
+}
@@ -0,0 +1,51 @@
+package tfe
+
+import (
+	"fmt"
+	"log"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func resourceTFEWorkspaceMigrateState(
+	v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+	switch v {
+	case 0:
+		log.Println("[INFO] Found TFE Workspace State v0; migrating to v1")
+		return migrateTFEWorkspaceStateV0toV1(is, meta)
+	default:
+		return is, fmt.Errorf("Unexpected schema version: %d", v)
+	}
+}
+
+// migrateTFEWorkspaceStateV0toV1 rewrites the resource ID from the legacy
+// "<ORGANIZATION>/<WORKSPACE>" (or even older "<WORKSPACE>|<ORGANIZATION>")
+// format to the workspace's stable external ID, so that renaming a workspace
+// in place no longer requires replacing the resource.
+func migrateTFEWorkspaceStateV0toV1(is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+	if is.Empty() || is.ID == "" {
+		log.Println("[DEBUG] Empty workspace state; nothing to migrate")
+		return is, nil
+	}
+
+	log.Printf("[DEBUG] Attributes before migration: %#v", is.Attributes)
+
+	organization, name, err := unpackWorkspaceID(is.ID)
+	if err != nil {
+		return is, fmt.Errorf("Error unpacking legacy workspace ID %s: %v", is.ID, err)
+	}
+
+	tfeClient := meta.(*tfe.Client)
+
+	workspace, err := tfeClient.Workspaces.Read(ctx, organization, name)
+	if err != nil {
+		return is, fmt.Errorf("Error looking up workspace %s/%s during state migration: %v", organization, name, err)
+	}
+
+	is.ID = workspace.ID
+	is.Attributes["external_id"] = workspace.ID
+
+	log.Printf("[DEBUG] Attributes after migration: %#v", is.Attributes)
+	return is, nil
+}
@@ -0,0 +1,44 @@
+package tfe
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestResourceTFEWorkspaceMigrateState_empty(t *testing.T) {
+	is := &terraform.InstanceState{}
+
+	out, err := resourceTFEWorkspaceMigrateState(0, is, nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if out != is {
+		t.Fatalf("expected empty state to be returned unchanged")
+	}
+}
+
+func TestResourceTFEWorkspaceMigrateState_invalidLegacyID(t *testing.T) {
+	is := &terraform.InstanceState{
+		ID:         "not-a-valid-legacy-id",
+		Attributes: map[string]string{},
+	}
+
+	_, err := resourceTFEWorkspaceMigrateState(0, is, nil)
+	if err == nil {
+		t.Fatal("expected an error for an invalid legacy workspace ID format")
+	}
+}
+
+func TestResourceTFEWorkspaceMigrateState_unknownVersion(t *testing.T) {
+	is := &terraform.InstanceState{
+		ID:         "org-name/workspace-name",
+		Attributes: map[string]string{},
+	}
+
+	_, err := resourceTFEWorkspaceMigrateState(1, is, nil)
+	if err == nil {
+		t.Fatal("expected an error for an unexpected schema version")
+	}
+}
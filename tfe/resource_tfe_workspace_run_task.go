@@ -0,0 +1,150 @@
+package tfe
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceTFEWorkspaceRunTask() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTFEWorkspaceRunTaskCreate,
+		Read:   resourceTFEWorkspaceRunTaskRead,
+		Update: resourceTFEWorkspaceRunTaskUpdate,
+		Delete: resourceTFEWorkspaceRunTaskDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceTFEWorkspaceRunTaskImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"task_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"workspace_external_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"enforcement_level": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(tfe.TaskEnforcementAdvisory),
+				ValidateFunc: validation.StringInSlice(
+					[]string{
+						string(tfe.TaskEnforcementAdvisory),
+						string(tfe.TaskEnforcementMandatory),
+					},
+					false,
+				),
+			},
+		},
+	}
+}
+
+func resourceTFEWorkspaceRunTaskCreate(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	taskID := d.Get("task_id").(string)
+	wsID := d.Get("workspace_external_id").(string)
+
+	options := tfe.WorkspaceRunTaskCreateOptions{
+		RunTask:          &tfe.RunTask{ID: taskID},
+		EnforcementLevel: tfe.TaskEnforcementLevel(d.Get("enforcement_level").(string)),
+	}
+
+	log.Printf("[DEBUG] Attach run task %s to workspace: %s", taskID, wsID)
+	wrt, err := tfeClient.WorkspaceRunTasks.Create(ctx, wsID, options)
+	if err != nil {
+		return fmt.Errorf("Error attaching run task %s to workspace %s: %v", taskID, wsID, err)
+	}
+
+	d.SetId(wrt.ID)
+
+	return resourceTFEWorkspaceRunTaskRead(d, meta)
+}
+
+// resourceTFEWorkspaceRunTaskImport parses the
+// <WORKSPACE EXTERNAL ID>/<WORKSPACE RUN TASK ID> import ID, since a
+// workspace run task cannot be read without knowing the workspace it
+// belongs to.
+func resourceTFEWorkspaceRunTaskImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	s := strings.SplitN(d.Id(), "/", 2)
+	if len(s) != 2 {
+		return nil, fmt.Errorf(
+			"invalid workspace run task import format: %s (expected <WORKSPACE EXTERNAL ID>/<WORKSPACE RUN TASK ID>)", d.Id())
+	}
+
+	d.Set("workspace_external_id", s[0])
+	d.SetId(s[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceTFEWorkspaceRunTaskRead(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	wsID := d.Get("workspace_external_id").(string)
+
+	log.Printf("[DEBUG] Read workspace run task: %s", d.Id())
+	wrt, err := tfeClient.WorkspaceRunTasks.Read(ctx, wsID, d.Id())
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			log.Printf("[DEBUG] Workspace run task %s does no longer exist", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading workspace run task %s: %v", d.Id(), err)
+	}
+
+	d.Set("enforcement_level", string(wrt.EnforcementLevel))
+	if wrt.RunTask != nil {
+		d.Set("task_id", wrt.RunTask.ID)
+	}
+
+	return nil
+}
+
+func resourceTFEWorkspaceRunTaskUpdate(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	wsID := d.Get("workspace_external_id").(string)
+
+	if d.HasChange("enforcement_level") {
+		options := tfe.WorkspaceRunTaskUpdateOptions{
+			EnforcementLevel: tfe.TaskEnforcementLevel(d.Get("enforcement_level").(string)),
+		}
+
+		log.Printf("[DEBUG] Update workspace run task: %s", d.Id())
+		_, err := tfeClient.WorkspaceRunTasks.Update(ctx, wsID, d.Id(), options)
+		if err != nil {
+			return fmt.Errorf("Error updating workspace run task %s: %v", d.Id(), err)
+		}
+	}
+
+	return resourceTFEWorkspaceRunTaskRead(d, meta)
+}
+
+func resourceTFEWorkspaceRunTaskDelete(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	wsID := d.Get("workspace_external_id").(string)
+
+	log.Printf("[DEBUG] Detach run task: %s", d.Id())
+	err := tfeClient.WorkspaceRunTasks.Delete(ctx, wsID, d.Id())
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			return nil
+		}
+		return fmt.Errorf("Error detaching run task %s: %v", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package tfe
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccTFEWorkspaceRunTask_basic(t *testing.T) {
+	wrt := &tfe.WorkspaceRunTask{}
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEWorkspaceRunTaskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspaceRunTask_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEWorkspaceRunTaskExists(
+						"tfe_workspace_run_task.foobar", wrt),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_run_task.foobar", "enforcement_level", "advisory"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTFEWorkspaceRunTask_import(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEWorkspaceRunTaskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspaceRunTask_basic(rInt),
+			},
+			{
+				ResourceName:      "tfe_workspace_run_task.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccTFEWorkspaceRunTaskImportStateIdFunc,
+			},
+		},
+	})
+}
+
+func testAccTFEWorkspaceRunTaskImportStateIdFunc(s *terraform.State) (string, error) {
+	rs, ok := s.RootModule().Resources["tfe_workspace_run_task.foobar"]
+	if !ok {
+		return "", fmt.Errorf("Not found: tfe_workspace_run_task.foobar")
+	}
+
+	return fmt.Sprintf("%s/%s", rs.Primary.Attributes["workspace_external_id"], rs.Primary.ID), nil
+}
+
+func testAccCheckTFEWorkspaceRunTaskExists(n string, wrt *tfe.WorkspaceRunTask) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		tfeClient := testAccProvider.Meta().(*tfe.Client)
+
+		found, err := tfeClient.WorkspaceRunTasks.Read(
+			ctx, rs.Primary.Attributes["workspace_external_id"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Workspace run task not found")
+		}
+
+		*wrt = *found
+
+		return nil
+	}
+}
+
+func testAccCheckTFEWorkspaceRunTaskDestroy(s *terraform.State) error {
+	tfeClient := testAccProvider.Meta().(*tfe.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tfe_workspace_run_task" {
+			continue
+		}
+
+		_, err := tfeClient.WorkspaceRunTasks.Read(
+			ctx, rs.Primary.Attributes["workspace_external_id"], rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Workspace run task %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccTFEWorkspaceRunTask_basic(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test-%d"
+  organization = "%s"
+}
+
+resource "tfe_workspace_run_task" "foobar" {
+  task_id               = "%s"
+  workspace_external_id = tfe_workspace.foobar.external_id
+  enforcement_level     = "advisory"
+}`, rInt, os.Getenv("TFE_ORGANIZATION"), os.Getenv("TFE_RUN_TASK_ID"))
+}
@@ -0,0 +1,323 @@
+package tfe
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccTFEWorkspace_basic(t *testing.T) {
+	workspace := &tfe.Workspace{}
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspace_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEWorkspaceExists(
+						"tfe_workspace.foobar", workspace),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "name", fmt.Sprintf("workspace-test-%d", rInt)),
+					resource.TestCheckResourceAttrSet(
+						"tfe_workspace.foobar", "external_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTFEWorkspace_import(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspace_basic(rInt),
+			},
+			{
+				ResourceName:      "tfe_workspace.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTFEWorkspaceExists(n string, workspace *tfe.Workspace) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		tfeClient := testAccProvider.Meta().(*tfe.Client)
+
+		found, err := tfeClient.Workspaces.ReadByID(ctx, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Workspace not found")
+		}
+
+		*workspace = *found
+
+		return nil
+	}
+}
+
+func testAccCheckTFEWorkspaceDestroy(s *terraform.State) error {
+	tfeClient := testAccProvider.Meta().(*tfe.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tfe_workspace" {
+			continue
+		}
+
+		_, err := tfeClient.Workspaces.ReadByID(ctx, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Workspace %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccTFEWorkspace_basic(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test-%d"
+  organization = "%s"
+}`, rInt, os.Getenv("TFE_ORGANIZATION"))
+}
+
+func TestAccTFEWorkspace_runTriggers(t *testing.T) {
+	workspace := &tfe.Workspace{}
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspace_runTriggers(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEWorkspaceExists(
+						"tfe_workspace.foobar", workspace),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "cost_estimation_enabled", "true"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "run_triggers.#", "2"),
+				),
+			},
+			{
+				// Reordering the same two sourceable workspaces must not
+				// produce a diff: run_triggers is unordered.
+				Config: testAccTFEWorkspace_runTriggersReordered(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEWorkspaceExists(
+						"tfe_workspace.foobar", workspace),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "run_triggers.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTFEWorkspace_runTriggers(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_workspace" "sourceable_a" {
+  name         = "workspace-trigger-a-%[1]d"
+  organization = "%[2]s"
+}
+
+resource "tfe_workspace" "sourceable_b" {
+  name         = "workspace-trigger-b-%[1]d"
+  organization = "%[2]s"
+}
+
+resource "tfe_workspace" "foobar" {
+  name                    = "workspace-test-%[1]d"
+  organization            = "%[2]s"
+  cost_estimation_enabled = true
+
+  run_triggers = [
+    tfe_workspace.sourceable_a.external_id,
+    tfe_workspace.sourceable_b.external_id,
+  ]
+}`, rInt, os.Getenv("TFE_ORGANIZATION"))
+}
+
+func testAccTFEWorkspace_runTriggersReordered(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_workspace" "sourceable_a" {
+  name         = "workspace-trigger-a-%[1]d"
+  organization = "%[2]s"
+}
+
+resource "tfe_workspace" "sourceable_b" {
+  name         = "workspace-trigger-b-%[1]d"
+  organization = "%[2]s"
+}
+
+resource "tfe_workspace" "foobar" {
+  name                    = "workspace-test-%[1]d"
+  organization            = "%[2]s"
+  cost_estimation_enabled = true
+
+  run_triggers = [
+    tfe_workspace.sourceable_b.external_id,
+    tfe_workspace.sourceable_a.external_id,
+  ]
+}`, rInt, os.Getenv("TFE_ORGANIZATION"))
+}
+
+func TestAccTFEWorkspace_policySetIDs(t *testing.T) {
+	workspace := &tfe.Workspace{}
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspace_policySetIDs(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEWorkspaceExists(
+						"tfe_workspace.foobar", workspace),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "policy_set_ids.#", "1"),
+				),
+			},
+			{
+				// Removing the policy set from config must detach it from
+				// the workspace, not merely stop tracking it.
+				Config: testAccTFEWorkspace_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEWorkspaceExists(
+						"tfe_workspace.foobar", workspace),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "policy_set_ids.#", "0"),
+					testAccCheckTFEWorkspaceNotAttachedToPolicySet(
+						os.Getenv("TFE_POLICY_SET_ID"), workspace),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTFEWorkspaceNotAttachedToPolicySet(policySetID string, workspace *tfe.Workspace) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		tfeClient := testAccProvider.Meta().(*tfe.Client)
+
+		ps, err := tfeClient.PolicySets.Read(ctx, policySetID)
+		if err != nil {
+			return err
+		}
+
+		for _, w := range ps.Workspaces {
+			if w.ID == workspace.ID {
+				return fmt.Errorf("Workspace %s is still attached to policy set %s", workspace.ID, policySetID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccTFEWorkspace_policySetIDs(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test-%d"
+  organization = "%s"
+
+  policy_set_ids = ["%s"]
+}`, rInt, os.Getenv("TFE_ORGANIZATION"), os.Getenv("TFE_POLICY_SET_ID"))
+}
+
+func TestAccTFEWorkspace_configuration(t *testing.T) {
+	workspace := &tfe.Workspace{}
+	rInt := acctest.RandInt()
+
+	dir, err := ioutil.TempDir("", "tfe-configuration-test")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	writeTestConfigurationFile(t, dir, "resource \"null_resource\" \"foo\" {}\n")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspace_configuration(rInt, dir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEWorkspaceExists(
+						"tfe_workspace.foobar", workspace),
+					resource.TestCheckResourceAttrSet(
+						"tfe_workspace.foobar", "configuration.0.directory_sha256"),
+				),
+			},
+			{
+				// Changing a file inside the directory without changing the
+				// directory path must still produce a diff, since the
+				// checksum is what CustomizeDiff recomputes.
+				PreConfig: func() {
+					writeTestConfigurationFile(t, dir, "resource \"null_resource\" \"foo\" {}\n# changed\n")
+				},
+				Config:             testAccTFEWorkspace_configuration(rInt, dir),
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEWorkspaceExists(
+						"tfe_workspace.foobar", workspace),
+					resource.TestCheckResourceAttrSet(
+						"tfe_workspace.foobar", "configuration.0.directory_sha256"),
+				),
+			},
+		},
+	})
+}
+
+func writeTestConfigurationFile(t *testing.T, dir, contents string) {
+	if err := ioutil.WriteFile(filepath.Join(dir, "main.tf"), []byte(contents), 0644); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func testAccTFEWorkspace_configuration(rInt int, dir string) string {
+	return fmt.Sprintf(`
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test-%d"
+  organization = "%s"
+
+  configuration {
+    directory       = "%s"
+    auto_queue_runs = false
+  }
+}`, rInt, os.Getenv("TFE_ORGANIZATION"), dir)
+}